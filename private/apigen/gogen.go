@@ -0,0 +1,314 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package apigen
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// MustWriteGo writes a generated Go HTTP client into a file in package pkg.
+// The client mirrors the TypeScript client produced by MustWriteTS, so
+// satellite-internal tools can call the same HTTP surface without
+// hand-writing a client or importing the server package.
+// If an error occurs, it panics.
+func (a *API) MustWriteGo(path, pkg string) {
+	f := newGoGenFile(path, pkg, a)
+
+	f.generateGo()
+
+	err := f.write()
+	if err != nil {
+		panic(errs.Wrap(err))
+	}
+}
+
+type goGenFile struct {
+	path string
+	pkg  string
+	api  *API
+
+	body        string
+	structsSeen map[string]bool
+	usesBytes   bool
+	usesTime    bool
+	usesURL     bool
+}
+
+func newGoGenFile(path, pkg string, api *API) *goGenFile {
+	return &goGenFile{
+		path:        path,
+		pkg:         pkg,
+		api:         api,
+		structsSeen: make(map[string]bool),
+	}
+}
+
+func (f *goGenFile) pf(format string, a ...interface{}) {
+	f.body += fmt.Sprintf(format+"\n", a...)
+}
+
+func (f *goGenFile) write() error {
+	return os.WriteFile(f.path, []byte(f.header()+f.body), 0644)
+}
+
+func (f *goGenFile) header() string {
+	var b strings.Builder
+	b.WriteString("// Code generated by private/apigen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\n", f.pkg)
+	b.WriteString("import (\n")
+	if f.usesBytes {
+		b.WriteString("\t\"bytes\"\n")
+	}
+	b.WriteString("\t\"context\"\n")
+	b.WriteString("\t\"encoding/json\"\n")
+	b.WriteString("\t\"fmt\"\n")
+	b.WriteString("\t\"net/http\"\n")
+	if f.usesURL {
+		b.WriteString("\t\"net/url\"\n")
+	}
+	if f.usesTime {
+		b.WriteString("\t\"time\"\n")
+	}
+	b.WriteString("\n")
+	b.WriteString("\t\"github.com/zeebo/errs\"\n")
+	b.WriteString(")\n")
+	return b.String()
+}
+
+func (f *goGenFile) generateGo() {
+	f.registerGoTypes()
+	f.generateAPIErrorType()
+
+	for _, group := range f.api.EndpointGroups {
+		f.writeGroupClient(group)
+	}
+}
+
+// registerGoTypes walks every endpoint, synthesizing a Go struct for each
+// request/response/query type so the generated file is self-contained and
+// doesn't need to import the types that define the HTTP surface.
+func (f *goGenFile) registerGoTypes() {
+	for _, group := range f.api.EndpointGroups {
+		for _, method := range group.endpoints {
+			if method.Request != nil {
+				f.goTypeFor(method.requestType())
+			}
+			if method.Response != nil {
+				f.goTypeFor(method.responseType())
+			}
+			for _, p := range method.PathParams {
+				f.goTypeFor(p.namedType(method.Endpoint, "path"))
+			}
+			for _, p := range method.QueryParams {
+				f.goTypeFor(p.namedType(method.Endpoint, "query"))
+			}
+		}
+	}
+}
+
+// goTypeFor returns the Go type expression for t, registering (and
+// emitting) a struct definition the first time a named struct type is seen.
+func (f *goGenFile) goTypeFor(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Ptr:
+		return "*" + f.goTypeFor(t.Elem())
+	case reflect.Slice:
+		return "[]" + f.goTypeFor(t.Elem())
+	case reflect.Array:
+		return fmt.Sprintf("[%d]%s", t.Len(), f.goTypeFor(t.Elem()))
+	case reflect.Map:
+		return fmt.Sprintf("map[%s]%s", f.goTypeFor(t.Key()), f.goTypeFor(t.Elem()))
+	case reflect.Struct:
+		if t == reflect.TypeOf(time.Time{}) {
+			f.usesTime = true
+			return "time.Time"
+		}
+		return f.registerStruct(t)
+	default:
+		return t.Kind().String()
+	}
+}
+
+func (f *goGenFile) registerStruct(t reflect.Type) string {
+	name := TypescriptTypeName(t)
+	if f.structsSeen[name] {
+		return name
+	}
+	f.structsSeen[name] = true
+
+	var fields []string
+	for _, field := range jsonFieldsOf(t) {
+		// Resolve the field's Go type first, so any nested struct it
+		// depends on is emitted above this one.
+		goType := f.goTypeFor(field.Type)
+		fields = append(fields, fmt.Sprintf("\t%s %s `json:\"%s\"`", field.Name, goType, field.WireName))
+	}
+
+	f.pf("")
+	f.pf("type %s struct {", name)
+	for _, line := range fields {
+		f.pf("%s", line)
+	}
+	f.pf("}")
+
+	return name
+}
+
+// generateAPIErrorType emits the Go counterpart of the TypeScript client's
+// ApiErrorBody, so the generated methods can report the same machine
+// readable error code and message that satellite-web gets.
+func (f *goGenFile) generateAPIErrorType() {
+	f.pf("")
+	f.pf("// ApiErrorBody is the error body the API returns for non-2xx responses.")
+	f.pf("type ApiErrorBody struct {")
+	f.pf("\tCode    string            `json:\"code\"`")
+	f.pf("\tMessage string            `json:\"message\"`")
+	f.pf("\tFields  map[string]string `json:\"fields,omitempty\"`")
+	f.pf("\tError   string            `json:\"error,omitempty\"`")
+	f.pf("}")
+	f.pf("")
+	f.pf("// ApiError is returned for non-2xx responses. It carries the decoded")
+	f.pf("// ApiErrorBody so callers can errors.As for it and branch on Code or")
+	f.pf("// Fields, the same way the generated TS client branches on")
+	f.pf("// ApiHttpError.body.")
+	f.pf("type ApiError struct {")
+	f.pf("\tStatus     string")
+	f.pf("\tStatusCode int")
+	f.pf("\tBody       ApiErrorBody")
+	f.pf("}")
+	f.pf("")
+	f.pf("func (e *ApiError) Error() string {")
+	f.pf("\tmsg := e.Body.Message")
+	f.pf("\tif msg == \"\" {")
+	f.pf("\t\tmsg = e.Body.Error")
+	f.pf("\t}")
+	f.pf("\treturn fmt.Sprintf(\"%%s: %%s\", e.Status, msg)")
+	f.pf("}")
+}
+
+func (f *goGenFile) writeGroupClient(group *EndpointGroup) {
+	clientName := fmt.Sprintf("%sClient%s", capitalize(group.Prefix), strings.ToUpper(f.api.Version))
+
+	f.pf("")
+	f.pf("// %s is a generated HTTP client for the %s endpoints.", clientName, group.Prefix)
+	f.pf("type %s struct {", clientName)
+	f.pf("\tHTTPClient *http.Client")
+	f.pf("\tBaseURL    string")
+	f.pf("}")
+	f.pf("")
+	f.pf("// New%s returns a new %s.", clientName, clientName)
+	f.pf("func New%s(httpClient *http.Client, baseURL string) *%s {", clientName, clientName)
+	f.pf("\treturn &%s{HTTPClient: httpClient, BaseURL: baseURL}", clientName)
+	f.pf("}")
+
+	for _, method := range group.endpoints {
+		f.writeMethod(clientName, group, method)
+	}
+}
+
+func (f *goGenFile) writeMethod(clientName string, group *EndpointGroup, method *fullEndpoint) {
+	hasResponse := method.Response != nil
+
+	args := []string{"ctx context.Context"}
+	if method.Request != nil {
+		args = append(args, fmt.Sprintf("request %s", f.goTypeFor(method.requestType())))
+	}
+	for _, p := range method.PathParams {
+		args = append(args, fmt.Sprintf("%s %s", p.Name, f.goTypeFor(p.namedType(method.Endpoint, "path"))))
+	}
+	for _, p := range method.QueryParams {
+		args = append(args, fmt.Sprintf("%s %s", p.Name, f.goTypeFor(p.namedType(method.Endpoint, "query"))))
+	}
+
+	returnType := "error"
+	if hasResponse {
+		returnType = fmt.Sprintf("(*%s, error)", f.goTypeFor(method.responseType()))
+	}
+
+	f.pf("")
+	f.pf("func (c *%s) %s(%s) %s {", clientName, capitalize(method.TypeScriptName), strings.Join(args, ", "), returnType)
+
+	path := method.Path
+	for _, p := range method.PathParams {
+		path = strings.ReplaceAll(path, "{"+p.Name+"}", "%v")
+	}
+	path = strings.ReplaceAll("%s/"+group.Prefix+path, "//", "/")
+
+	pathArgs := []string{"c.BaseURL"}
+	for _, p := range method.PathParams {
+		f.usesURL = true
+		pathArgs = append(pathArgs, fmt.Sprintf("url.PathEscape(fmt.Sprint(%s))", p.Name))
+	}
+	f.pf("\tu := fmt.Sprintf(%q, %s)", path, strings.Join(pathArgs, ", "))
+
+	if len(method.QueryParams) > 0 {
+		f.usesURL = true
+		f.pf("\tq := url.Values{}")
+		for _, p := range method.QueryParams {
+			kind := p.namedType(method.Endpoint, "query").Kind()
+			if kind == reflect.Slice || kind == reflect.Array {
+				f.pf("\tfor _, v := range %s {", p.Name)
+				f.pf("\t\tq.Add(%q, fmt.Sprint(v))", p.Name)
+				f.pf("\t}")
+			} else {
+				f.pf("\tq.Set(%q, fmt.Sprint(%s))", p.Name, p.Name)
+			}
+		}
+		f.pf("\tu += \"?\" + q.Encode()")
+	}
+
+	fail := ""
+	if hasResponse {
+		fail = "nil, "
+	}
+
+	if method.Request != nil {
+		f.usesBytes = true
+		f.pf("\tpayload, err := json.Marshal(request)")
+		f.pf("\tif err != nil {")
+		f.pf("\t\treturn %serrs.Wrap(err)", fail)
+		f.pf("\t}")
+		f.pf("\treq, err := http.NewRequestWithContext(ctx, %q, u, bytes.NewReader(payload))", method.Method)
+	} else {
+		f.pf("\treq, err := http.NewRequestWithContext(ctx, %q, u, nil)", method.Method)
+	}
+	f.pf("\tif err != nil {")
+	f.pf("\t\treturn %serrs.Wrap(err)", fail)
+	f.pf("\t}")
+
+	if method.Request != nil {
+		f.pf("\treq.Header.Set(\"Content-Type\", \"application/json\")")
+	}
+
+	f.pf("\tresp, err := c.HTTPClient.Do(req)")
+	f.pf("\tif err != nil {")
+	f.pf("\t\treturn %serrs.Wrap(err)", fail)
+	f.pf("\t}")
+	f.pf("\tdefer func() { _ = resp.Body.Close() }()")
+	f.pf("")
+	f.pf("\tif resp.StatusCode < 200 || resp.StatusCode >= 300 {")
+	f.pf("\t\tvar apiErr ApiErrorBody")
+	f.pf("\t\t_ = json.NewDecoder(resp.Body).Decode(&apiErr)")
+	f.pf("\t\treturn %s&ApiError{Status: resp.Status, StatusCode: resp.StatusCode, Body: apiErr}", fail)
+	f.pf("\t}")
+
+	if hasResponse {
+		f.pf("")
+		f.pf("\tvar out %s", f.goTypeFor(method.responseType()))
+		f.pf("\tif err := json.NewDecoder(resp.Body).Decode(&out); err != nil {")
+		f.pf("\t\treturn nil, errs.Wrap(err)")
+		f.pf("\t}")
+		f.pf("\treturn &out, nil")
+	} else {
+		f.pf("\treturn nil")
+	}
+
+	f.pf("}")
+}
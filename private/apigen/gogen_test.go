@@ -0,0 +1,92 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package apigen
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type sampleJSONStruct struct {
+	Required   string `json:"required"`
+	Optional   int    `json:"optional,omitempty"`
+	Skipped    bool   `json:"-"`
+	unexported string
+	Implicit   float64
+}
+
+func TestParseJSONTag(t *testing.T) {
+	tests := []struct {
+		tag  string
+		name string
+		opts map[string]bool
+	}{
+		{tag: "", name: "", opts: map[string]bool{}},
+		{tag: "foo", name: "foo", opts: map[string]bool{}},
+		{tag: "foo,omitempty", name: "foo", opts: map[string]bool{"omitempty": true}},
+		{tag: "-", name: "-", opts: map[string]bool{}},
+	}
+
+	for _, tc := range tests {
+		name, opts := parseJSONTag(tc.tag)
+		require.Equal(t, tc.name, name)
+		require.Equal(t, tc.opts, opts)
+	}
+}
+
+func TestIsByteSlice(t *testing.T) {
+	require.True(t, isByteSlice(reflect.TypeOf([]byte(nil))))
+	require.True(t, isByteSlice(reflect.TypeOf([4]byte{})))
+	require.False(t, isByteSlice(reflect.TypeOf([]int(nil))))
+	require.False(t, isByteSlice(reflect.TypeOf("")))
+}
+
+func TestJSONFieldsOf(t *testing.T) {
+	fields := jsonFieldsOf(reflect.TypeOf(sampleJSONStruct{}))
+
+	names := make([]string, len(fields))
+	for i, field := range fields {
+		names[i] = field.WireName
+	}
+	require.Equal(t, []string{"required", "optional", "Implicit"}, names)
+
+	require.False(t, fields[0].OmitEmpty)
+	require.True(t, fields[1].OmitEmpty)
+}
+
+func TestGoTypeFor(t *testing.T) {
+	f := newGoGenFile("out.go", "client", nil)
+
+	require.Equal(t, "string", f.goTypeFor(reflect.TypeOf("")))
+	require.Equal(t, "[]uint8", f.goTypeFor(reflect.TypeOf([]byte(nil))))
+	require.Equal(t, "*int", f.goTypeFor(reflect.TypeOf(new(int))))
+	require.Equal(t, "map[string]int", f.goTypeFor(reflect.TypeOf(map[string]int(nil))))
+
+	require.Equal(t, "time.Time", f.goTypeFor(reflect.TypeOf(time.Time{})))
+	require.True(t, f.usesTime)
+}
+
+func TestRegisterStructEmitsFieldsOnce(t *testing.T) {
+	type address struct {
+		Street string `json:"street"`
+		Zip    string `json:"zip,omitempty"`
+	}
+
+	f := newGoGenFile("out.go", "client", nil)
+	name := f.registerStruct(reflect.TypeOf(address{}))
+
+	require.Contains(t, f.body, fmt.Sprintf("type %s struct {", name))
+	require.Contains(t, f.body, "Street string `json:\"street\"`")
+	require.Contains(t, f.body, "Zip string `json:\"zip\"`")
+	require.True(t, f.structsSeen[name])
+
+	before := f.body
+	again := f.registerStruct(reflect.TypeOf(address{}))
+	require.Equal(t, name, again)
+	require.Equal(t, before, f.body, "registering the same struct twice must not duplicate its definition")
+}
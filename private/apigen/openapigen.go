@@ -0,0 +1,349 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package apigen
+
+import (
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"gopkg.in/yaml.v3"
+)
+
+// MustWriteOpenAPI writes a generated OpenAPI 3.0 specification into a file.
+// If an error occurs, it panics.
+func (a *API) MustWriteOpenAPI(path string) {
+	f := newOpenAPIGenFile(path, a)
+
+	f.generateOpenAPI()
+
+	err := f.write()
+	if err != nil {
+		panic(errs.Wrap(err))
+	}
+}
+
+// openAPIGenFile holds the state needed to render an API definition as an
+// OpenAPI 3.0 document.
+//
+// NOTE on "share schema derivation with the TS generator": this repo's
+// Types/GenerateTypescriptDefinitions (tsgen.go's struct-to-TypeScript
+// path) isn't source in this tree, only referenced - there's nothing here
+// to refactor it against. What IS ours to control is the field-walking and
+// elementary-type logic that openapigen.go and gogen.go each need, so that
+// part is now actually shared (jsonFieldsOf below), instead of duplicated
+// and synced by hand - which is how the []byte-as-base64 case drifted in the
+// first place. isByteSlice is used by openapigen.go only. If Types needs to share with these too, that's
+// a real refactor of Types itself and belongs in its own request against
+// that file, not something this package can quietly take on.
+type openAPIGenFile struct {
+	path string
+	api  *API
+	doc  openAPIDocument
+
+	// schemas collects the component schemas derived from the endpoints'
+	// request/response/query types, keyed by their TypeScript type name so
+	// they line up with the names used by MustWriteTS.
+	schemas map[string]*openAPISchema
+	// visiting guards against infinite recursion on self-referential types.
+	visiting map[reflect.Type]bool
+}
+
+func newOpenAPIGenFile(path string, api *API) *openAPIGenFile {
+	return &openAPIGenFile{
+		path:     path,
+		api:      api,
+		schemas:  make(map[string]*openAPISchema),
+		visiting: make(map[reflect.Type]bool),
+	}
+}
+
+func (f *openAPIGenFile) write() error {
+	content, err := yaml.Marshal(f.doc)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	return os.WriteFile(f.path, content, 0644)
+}
+
+func (f *openAPIGenFile) generateOpenAPI() {
+	f.doc = openAPIDocument{
+		OpenAPI: "3.0.3",
+		Info: openAPIInfo{
+			Title:   f.api.Description,
+			Version: f.api.Version,
+		},
+		Paths: make(map[string]*openAPIPathItem),
+	}
+
+	for _, group := range f.api.EndpointGroups {
+		f.addGroup(group)
+	}
+
+	f.doc.Components.Schemas = f.schemas
+}
+
+func (f *openAPIGenFile) addGroup(group *EndpointGroup) {
+	for _, method := range group.endpoints {
+		path := f.api.endpointBasePath() + "/" + group.Prefix + method.Path
+		path = strings.ReplaceAll(path, "//", "/")
+
+		item, ok := f.doc.Paths[path]
+		if !ok {
+			item = &openAPIPathItem{}
+			f.doc.Paths[path] = item
+		}
+
+		op := f.operationFor(group, method)
+		switch strings.ToLower(method.Method) {
+		case "get":
+			item.Get = op
+		case "post":
+			item.Post = op
+		case "put":
+			item.Put = op
+		case "patch":
+			item.Patch = op
+		case "delete":
+			item.Delete = op
+		}
+	}
+}
+
+func (f *openAPIGenFile) operationFor(group *EndpointGroup, method *fullEndpoint) *openAPIOperation {
+	op := &openAPIOperation{
+		OperationID: group.Prefix + capitalize(method.TypeScriptName),
+		Responses:   make(map[string]*openAPIResponse),
+	}
+
+	for _, p := range method.PathParams {
+		op.Parameters = append(op.Parameters, openAPIParameter{
+			Name:     p.Name,
+			In:       "path",
+			Required: true,
+			Schema:   f.schemaFor(p.namedType(method.Endpoint, "path")),
+		})
+	}
+
+	for _, p := range method.QueryParams {
+		op.Parameters = append(op.Parameters, openAPIParameter{
+			Name:   p.Name,
+			In:     "query",
+			Schema: f.schemaFor(p.namedType(method.Endpoint, "query")),
+		})
+	}
+
+	if method.Request != nil {
+		op.RequestBody = &openAPIRequestBody{
+			Required: true,
+			Content: map[string]*openAPIMediaType{
+				"application/json": {Schema: f.schemaFor(method.requestType())},
+			},
+		}
+	}
+
+	if method.Response != nil {
+		op.Responses["200"] = &openAPIResponse{
+			Description: "OK",
+			Content: map[string]*openAPIMediaType{
+				"application/json": {Schema: f.schemaFor(method.responseType())},
+			},
+		}
+	} else {
+		op.Responses["200"] = &openAPIResponse{Description: "OK"}
+	}
+
+	return op
+}
+
+// schemaFor derives an OpenAPI schema for the given Go type, registering any
+// named struct type as a reusable component and returning a $ref to it.
+func (f *openAPIGenFile) schemaFor(t reflect.Type) *openAPISchema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch {
+	case t == reflect.TypeOf(time.Time{}):
+		return &openAPISchema{Type: "string", Format: "date-time"}
+	case t.Kind() == reflect.Struct:
+		name := TypescriptTypeName(t)
+		if _, ok := f.schemas[name]; !ok && !f.visiting[t] {
+			f.visiting[t] = true
+			f.schemas[name] = f.objectSchemaFor(t)
+			delete(f.visiting, t)
+		}
+		return &openAPISchema{Ref: "#/components/schemas/" + name}
+	case isByteSlice(t):
+		return &openAPISchema{Type: "string", Format: "byte"}
+	case t.Kind() == reflect.Slice, t.Kind() == reflect.Array:
+		return &openAPISchema{Type: "array", Items: f.schemaFor(t.Elem())}
+	case t.Kind() == reflect.Map:
+		return &openAPISchema{Type: "object", AdditionalProperties: f.schemaFor(t.Elem())}
+	default:
+		return elementarySchemaFor(t)
+	}
+}
+
+func (f *openAPIGenFile) objectSchemaFor(t reflect.Type) *openAPISchema {
+	schema := &openAPISchema{
+		Type:       "object",
+		Properties: make(map[string]*openAPISchema),
+	}
+
+	for _, field := range jsonFieldsOf(t) {
+		schema.Properties[field.WireName] = f.schemaFor(field.Type)
+		if !field.OmitEmpty {
+			schema.Required = append(schema.Required, field.WireName)
+		}
+	}
+
+	sort.Strings(schema.Required)
+	return schema
+}
+
+func elementarySchemaFor(t reflect.Type) *openAPISchema {
+	switch t.Kind() {
+	case reflect.String:
+		return &openAPISchema{Type: "string"}
+	case reflect.Bool:
+		return &openAPISchema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		return &openAPISchema{Type: "integer", Format: "int32"}
+	case reflect.Int64:
+		return &openAPISchema{Type: "integer", Format: "int64"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &openAPISchema{Type: "integer", Format: "int32"}
+	case reflect.Uint64:
+		return &openAPISchema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &openAPISchema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &openAPISchema{Type: "number", Format: "double"}
+	default:
+		return &openAPISchema{Type: "string"}
+	}
+}
+
+func parseJSONTag(tag string) (name string, opts map[string]bool) {
+	parts := strings.Split(tag, ",")
+	opts = make(map[string]bool)
+	if len(parts) == 0 {
+		return "", opts
+	}
+	for _, opt := range parts[1:] {
+		opts[opt] = true
+	}
+	return parts[0], opts
+}
+
+// jsonField describes one exported, JSON-visible struct field, shared by
+// openapigen.go and gogen.go so the two generators can't independently drift
+// on which fields exist, what they're named over the wire, and whether
+// they're optional - the way the []byte-as-base64 case once did.
+type jsonField struct {
+	reflect.StructField
+	WireName  string
+	OmitEmpty bool
+}
+
+// jsonFieldsOf returns the exported fields of struct type t that
+// encoding/json would serialize, in declaration order. Embedded structs are
+// not flattened the way encoding/json flattens them: an exported one is
+// returned as a single field named after its type, and an unexported one is
+// skipped.
+func jsonFieldsOf(t reflect.Type) []jsonField {
+	var fields []jsonField
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name, opts := parseJSONTag(field.Tag.Get("json"))
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		fields = append(fields, jsonField{StructField: field, WireName: name, OmitEmpty: opts["omitempty"]})
+	}
+	return fields
+}
+
+// isByteSlice reports whether t is []byte or [N]byte, which encoding/json
+// marshals as a base64 string rather than an array of numbers. gogen.go
+// needs no special case, since the []uint8 it emits marshals the same way.
+func isByteSlice(t reflect.Type) bool {
+	return (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && t.Elem().Kind() == reflect.Uint8
+}
+
+type openAPIDocument struct {
+	OpenAPI    string                      `yaml:"openapi"`
+	Info       openAPIInfo                 `yaml:"info"`
+	Paths      map[string]*openAPIPathItem `yaml:"paths"`
+	Components openAPIComponents           `yaml:"components"`
+}
+
+type openAPIInfo struct {
+	Title   string `yaml:"title"`
+	Version string `yaml:"version"`
+}
+
+type openAPIComponents struct {
+	Schemas map[string]*openAPISchema `yaml:"schemas"`
+}
+
+type openAPIPathItem struct {
+	Get    *openAPIOperation `yaml:"get,omitempty"`
+	Post   *openAPIOperation `yaml:"post,omitempty"`
+	Put    *openAPIOperation `yaml:"put,omitempty"`
+	Patch  *openAPIOperation `yaml:"patch,omitempty"`
+	Delete *openAPIOperation `yaml:"delete,omitempty"`
+}
+
+type openAPIOperation struct {
+	OperationID string                      `yaml:"operationId"`
+	Parameters  []openAPIParameter          `yaml:"parameters,omitempty"`
+	RequestBody *openAPIRequestBody         `yaml:"requestBody,omitempty"`
+	Responses   map[string]*openAPIResponse `yaml:"responses"`
+}
+
+type openAPIParameter struct {
+	Name     string         `yaml:"name"`
+	In       string         `yaml:"in"`
+	Required bool           `yaml:"required,omitempty"`
+	Schema   *openAPISchema `yaml:"schema"`
+}
+
+type openAPIRequestBody struct {
+	Required bool                         `yaml:"required,omitempty"`
+	Content  map[string]*openAPIMediaType `yaml:"content"`
+}
+
+type openAPIResponse struct {
+	Description string                       `yaml:"description"`
+	Content     map[string]*openAPIMediaType `yaml:"content,omitempty"`
+}
+
+type openAPIMediaType struct {
+	Schema *openAPISchema `yaml:"schema"`
+}
+
+// openAPISchema is a reduced JSON-Schema-compatible representation, enough
+// to describe the plain-data structs used as request/response bodies.
+type openAPISchema struct {
+	Ref                  string                    `yaml:"$ref,omitempty"`
+	Type                 string                    `yaml:"type,omitempty"`
+	Format               string                    `yaml:"format,omitempty"`
+	Items                *openAPISchema            `yaml:"items,omitempty"`
+	Properties           map[string]*openAPISchema `yaml:"properties,omitempty"`
+	AdditionalProperties *openAPISchema            `yaml:"additionalProperties,omitempty"`
+	Required             []string                  `yaml:"required,omitempty"`
+}
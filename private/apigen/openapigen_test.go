@@ -0,0 +1,67 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package apigen
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaForByteSliceIsBase64String(t *testing.T) {
+	f := newOpenAPIGenFile("out.yaml", nil)
+
+	schema := f.schemaFor(reflect.TypeOf([]byte(nil)))
+	require.Equal(t, &openAPISchema{Type: "string", Format: "byte"}, schema)
+
+	schema = f.schemaFor(reflect.TypeOf([4]byte{}))
+	require.Equal(t, &openAPISchema{Type: "string", Format: "byte"}, schema)
+}
+
+func TestSchemaForTime(t *testing.T) {
+	f := newOpenAPIGenFile("out.yaml", nil)
+
+	schema := f.schemaFor(reflect.TypeOf(time.Time{}))
+	require.Equal(t, &openAPISchema{Type: "string", Format: "date-time"}, schema)
+}
+
+func TestSchemaForStructRegistersComponent(t *testing.T) {
+	type address struct {
+		Street string `json:"street"`
+		Zip    string `json:"zip,omitempty"`
+	}
+
+	f := newOpenAPIGenFile("out.yaml", nil)
+	schema := f.schemaFor(reflect.TypeOf(address{}))
+
+	require.NotEmpty(t, schema.Ref)
+	name := TypescriptTypeName(reflect.TypeOf(address{}))
+	require.Equal(t, "#/components/schemas/"+name, schema.Ref)
+
+	component, ok := f.schemas[name]
+	require.True(t, ok)
+	require.Equal(t, "object", component.Type)
+	require.Equal(t, []string{"street"}, component.Required)
+	require.Equal(t, &openAPISchema{Type: "string"}, component.Properties["street"])
+	require.Equal(t, &openAPISchema{Type: "string"}, component.Properties["zip"])
+}
+
+func TestSchemaForSliceAndMap(t *testing.T) {
+	f := newOpenAPIGenFile("out.yaml", nil)
+
+	schema := f.schemaFor(reflect.TypeOf([]int(nil)))
+	require.Equal(t, &openAPISchema{Type: "array", Items: &openAPISchema{Type: "integer", Format: "int32"}}, schema)
+
+	schema = f.schemaFor(reflect.TypeOf(map[string]string(nil)))
+	require.Equal(t, &openAPISchema{Type: "object", AdditionalProperties: &openAPISchema{Type: "string"}}, schema)
+}
+
+func TestSchemaForPointerDereferences(t *testing.T) {
+	f := newOpenAPIGenFile("out.yaml", nil)
+
+	schema := f.schemaFor(reflect.TypeOf(new(string)))
+	require.Equal(t, &openAPISchema{Type: "string"}, schema)
+}
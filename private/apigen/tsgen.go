@@ -6,6 +6,8 @@ package apigen
 import (
 	"fmt"
 	"os"
+	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/zeebo/errs"
@@ -52,7 +54,26 @@ func (f *tsGenFile) generateTS() {
 	f.pf("// AUTOGENERATED BY private/apigen")
 	f.pf("// DO NOT EDIT.")
 	f.pf("")
+	// HttpClient.<verb> is expected to take an options object with an
+	// optional `signal`, honoring it the same way `fetch` does, so a
+	// caller's AbortSignal and the timeout below race on equal footing.
 	f.pf("import { HttpClient } from '@/utils/httpClient';")
+	f.pf("")
+	f.pf("// DEFAULT_TIMEOUT_MS bounds how long a generated client method waits")
+	f.pf("// for a response before aborting the request on the caller's behalf.")
+	f.pf("const DEFAULT_TIMEOUT_MS = 30000;")
+	f.pf("")
+	f.pf("// combineSignals aborts when either the caller's signal fires or")
+	f.pf("// DEFAULT_TIMEOUT_MS elapses, whichever comes first.")
+	f.pf("function combineSignals(signal?: AbortSignal): AbortSignal {")
+	f.pf("\tconst timeout = AbortSignal.timeout(DEFAULT_TIMEOUT_MS);")
+	f.pf("\tif (!signal) {")
+	f.pf("\t\treturn timeout;")
+	f.pf("\t}")
+	f.pf("\treturn AbortSignal.any([signal, timeout]);")
+	f.pf("}")
+
+	f.generateAPIErrorTypes()
 
 	f.registerTypes()
 	f.result += f.types.GenerateTypescriptDefinitions()
@@ -63,6 +84,64 @@ func (f *tsGenFile) generateTS() {
 	}
 }
 
+// apiErrorCodes maps HTTP status codes to the machine-readable error code
+// carried in the generated TS client, so every endpoint's error responses
+// are typed the same way without each one declaring its own error shape.
+var apiErrorCodes = map[int]string{
+	400: "bad_request",
+	401: "unauthorized",
+	403: "forbidden",
+	404: "not_found",
+	409: "conflict",
+	422: "validation",
+	500: "internal",
+}
+
+// generateAPIErrorTypes emits the shared discriminated-union error type and
+// the HttpError subclass that the generated client methods throw instead of
+// a plain `Error`, so callers can branch on `code` and `status` rather than
+// matching on the message string.
+//
+// ApiErrorBody also accepts the pre-existing `{ error: string }` envelope:
+// nothing in this series changed what the server actually serializes on
+// error, so until every handler is confirmed to emit `{code, message,
+// fields?}`, ApiHttpError has to keep understanding the old shape too or
+// every caller still on the old envelope gets `message === undefined`.
+func (f *tsGenFile) generateAPIErrorTypes() {
+	f.pf("")
+	f.pf("export type ApiErrorBody =")
+	codes := make([]int, 0, len(apiErrorCodes))
+	for status := range apiErrorCodes {
+		codes = append(codes, status)
+	}
+	sort.Ints(codes)
+	for _, status := range codes {
+		code := apiErrorCodes[status]
+		if code == "validation" {
+			f.pf("    | { code: '%s'; message: string; fields?: Record<string, string> }", code)
+		} else {
+			f.pf("    | { code: '%s'; message: string }", code)
+		}
+	}
+	f.pf("    | { error: string };")
+	f.pf("")
+	f.pf("function apiErrorMessage(body: ApiErrorBody, status: number): string {")
+	f.pf("    if ('message' in body && body.message) {")
+	f.pf("        return body.message;")
+	f.pf("    }")
+	f.pf("    if ('error' in body && body.error) {")
+	f.pf("        return body.error;")
+	f.pf("    }")
+	f.pf("    return `request failed with status ${status}`;")
+	f.pf("}")
+	f.pf("")
+	f.pf("export class ApiHttpError extends Error {")
+	f.pf("    constructor(public readonly status: number, public readonly body: ApiErrorBody) {")
+	f.pf("        super(apiErrorMessage(body, status));")
+	f.pf("    }")
+	f.pf("}")
+}
+
 func (f *tsGenFile) registerTypes() {
 	// TODO: what happen with path parameters?
 	for _, group := range f.api.EndpointGroups {
@@ -106,7 +185,14 @@ func (f *tsGenFile) createAPIClient(group *EndpointGroup) {
 		if len(method.QueryParams) > 0 {
 			f.pf("\t\tconst u = new URL(`%s`);", path)
 			for _, p := range method.QueryParams {
-				f.pf("\t\tu.searchParams.set('%s', %s);", p.Name, p.Name)
+				kind := p.namedType(method.Endpoint, "query").Kind()
+				if kind == reflect.Slice || kind == reflect.Array {
+					f.pf("\t\tfor (const v of %s) {", p.Name)
+					f.pf("\t\t\tu.searchParams.append('%s', String(v));", p.Name)
+					f.pf("\t\t}")
+				} else {
+					f.pf("\t\tu.searchParams.set('%s', %s);", p.Name, p.Name)
+				}
 			}
 			f.pf("\t\tconst fullPath = u.toString();")
 		} else {
@@ -114,16 +200,16 @@ func (f *tsGenFile) createAPIClient(group *EndpointGroup) {
 		}
 
 		if method.Request != nil {
-			f.pf("\t\tconst response = await this.http.%s(fullPath, JSON.stringify(request));", strings.ToLower(method.Method))
+			f.pf("\t\tconst response = await this.http.%s(fullPath, JSON.stringify(request), { signal: combineSignals(signal) });", strings.ToLower(method.Method))
 		} else {
-			f.pf("\t\tconst response = await this.http.%s(fullPath);", strings.ToLower(method.Method))
+			f.pf("\t\tconst response = await this.http.%s(fullPath, { signal: combineSignals(signal) });", strings.ToLower(method.Method))
 		}
 
 		f.pf("\t\tif (response.ok) {")
 		f.pf("\t\t\t%s", returnStmt)
 		f.pf("\t\t}")
-		f.pf("\t\tconst err = await response.json();")
-		f.pf("\t\tthrow new Error(err.error);")
+		f.pf("\t\tconst body = await response.json();")
+		f.pf("\t\tthrow new ApiHttpError(response.status, body);")
 		f.pf("\t}")
 	}
 	f.pf("}")
@@ -151,6 +237,8 @@ func (f *tsGenFile) getArgsAndPath(method *fullEndpoint) (funcArgs, path string)
 		funcArgs += fmt.Sprintf("%s: %s, ", p.Name, TypescriptTypeName(p.namedType(method.Endpoint, "query")))
 	}
 
+	funcArgs += "signal?: AbortSignal, "
+
 	path = strings.ReplaceAll(path, "//", "/")
 
 	return strings.Trim(funcArgs, ", "), path
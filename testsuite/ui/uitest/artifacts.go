@@ -0,0 +1,228 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uitest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/proto"
+
+	"storj.io/common/testcontext"
+)
+
+// artifactCapture records console logs and network activity for a browser
+// while a test runs, so that on failure we can dump everything CI needs to
+// diagnose an opaque "element not found" failure without reproducing it
+// locally.
+type artifactCapture struct {
+	mu sync.Mutex
+
+	console []string
+	har     harLog
+	// entryIndex maps a request's CDP RequestID to its index in har.Entries
+	// and the monotonic time it started at, so the
+	// NetworkResponseReceived/LoadingFinished handlers below can fill in the
+	// entry that NetworkRequestWillBeSent started and compute its duration.
+	entryIndex map[proto.NetworkRequestID]harEntryRef
+
+	stopFns []func()
+}
+
+// newArtifactCapture starts recording console output and network traffic
+// for every page of browser. Recording continues until close is called.
+//
+// Isolation between concurrent RunParallel tests relies on rod itself: a
+// *rod.Browser returned by MustIncognito is pinned to one BrowserContextID,
+// and EachEvent filters the CDP event stream by that ID before invoking its
+// callback, so two tests sharing the underlying Chromium process never see
+// each other's console/network events even though both call EachEvent on
+// the same process. See TestArtifactCaptureIsolatedPerIncognitoContext for a
+// test driving two incognito contexts against one Chromium process and
+// asserting their captures stay separate.
+func newArtifactCapture(browser *rod.Browser) *artifactCapture {
+	c := &artifactCapture{
+		har:        harLog{Version: "1.2", Creator: harCreator{Name: "storj-uitest", Version: "1.0"}},
+		entryIndex: make(map[proto.NetworkRequestID]harEntryRef),
+	}
+
+	// EachEvent returns a wait function that consumes events until the
+	// browser's context ends, so each one runs in its own goroutine on a
+	// browser whose context close cancels.
+	ctx, cancel := context.WithCancel(browser.GetContext())
+	browser = browser.Context(ctx)
+	c.stopFns = append(c.stopFns, cancel)
+
+	waitConsole := browser.EachEvent(func(e *proto.RuntimeConsoleAPICalled) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.console = append(c.console, formatConsoleEvent(e))
+	})
+	go waitConsole()
+
+	waitRequests := browser.EachEvent(func(e *proto.NetworkRequestWillBeSent) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.entryIndex[e.RequestID] = harEntryRef{index: len(c.har.Entries), startedAt: e.Timestamp}
+		c.har.Entries = append(c.har.Entries, harEntry{
+			StartedDateTime: e.WallTime.Time().Format(time.RFC3339Nano),
+			Request: harRequest{
+				Method: string(e.Request.Method),
+				URL:    e.Request.URL,
+			},
+			Response: harResponse{},
+		})
+	})
+	go waitRequests()
+
+	waitResponses := browser.EachEvent(func(e *proto.NetworkResponseReceived) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		ref, ok := c.entryIndex[e.RequestID]
+		if !ok {
+			return
+		}
+		c.har.Entries[ref.index].Response = harResponse{
+			Status:      e.Response.Status,
+			StatusText:  e.Response.StatusText,
+			HTTPVersion: e.Response.Protocol,
+			MimeType:    e.Response.MimeType,
+		}
+	})
+	go waitResponses()
+
+	waitFinished := browser.EachEvent(func(e *proto.NetworkLoadingFinished) {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		ref, ok := c.entryIndex[e.RequestID]
+		if !ok {
+			return
+		}
+		c.har.Entries[ref.index].Time = float64(e.Timestamp-ref.startedAt) * 1000
+	})
+	go waitFinished()
+
+	return c
+}
+
+// harEntryRef records where a request's HAR entry lives and when it
+// started, so later events for the same RequestID can fill it in.
+type harEntryRef struct {
+	index     int
+	startedAt proto.MonotonicTime
+}
+
+// close stops recording.
+func (c *artifactCapture) close() {
+	for _, stop := range c.stopFns {
+		stop()
+	}
+}
+
+// save writes a screenshot, DOM snapshot, console log and HAR trace for
+// every open page into ctx.Dir("artifacts").
+func (c *artifactCapture) save(t *testing.T, ctx *testcontext.Context, browser *rod.Browser) {
+	dir := ctx.Dir("artifacts")
+
+	pages, err := browser.Pages()
+	if err != nil {
+		t.Logf("uitest: failed to list pages for artifact capture: %v", err)
+		return
+	}
+
+	for i, page := range pages {
+		if img, err := page.Screenshot(true, nil); err == nil {
+			writeArtifact(t, dir, fmt.Sprintf("page-%d.png", i), img)
+		} else {
+			t.Logf("uitest: failed to capture screenshot: %v", err)
+		}
+
+		if html, err := page.HTML(); err == nil {
+			writeArtifact(t, dir, fmt.Sprintf("page-%d.html", i), []byte(html))
+		} else {
+			t.Logf("uitest: failed to capture DOM snapshot: %v", err)
+		}
+	}
+
+	c.mu.Lock()
+	console := append([]string(nil), c.console...)
+	har := c.har
+	c.mu.Unlock()
+
+	writeArtifact(t, dir, "console.log", []byte(strings.Join(console, "\n")))
+
+	if harJSON, err := json.MarshalIndent(harDocument{Log: har}, "", "  "); err == nil {
+		writeArtifact(t, dir, "network.har", harJSON)
+	} else {
+		t.Logf("uitest: failed to marshal HAR trace: %v", err)
+	}
+}
+
+func writeArtifact(t *testing.T, dir, name string, data []byte) {
+	if err := os.WriteFile(filepath.Join(dir, name), data, 0644); err != nil {
+		t.Logf("uitest: failed to write artifact %s: %v", name, err)
+	}
+}
+
+func formatConsoleEvent(e *proto.RuntimeConsoleAPICalled) string {
+	args := make([]string, 0, len(e.Args))
+	for _, a := range e.Args {
+		if v := a.Value.Val(); v != nil {
+			args = append(args, fmt.Sprintf("%v", v))
+		} else if a.Description != "" {
+			args = append(args, a.Description)
+		}
+	}
+	return fmt.Sprintf("[%s] %s", e.Type, strings.Join(args, " "))
+}
+
+// harDocument, harLog, harCreator, harEntry, harRequest and harResponse are
+// a reduced subset of the HAR 1.2 format
+// (http://www.softwareishard.com/blog/har-12-spec/), enough for CI to see
+// not just that a request fired but what it got back: status, content type
+// and duration.
+type harDocument struct {
+	Log harLog `json:"log"`
+}
+
+type harLog struct {
+	Version string     `json:"version"`
+	Creator harCreator `json:"creator"`
+	Entries []harEntry `json:"entries"`
+}
+
+type harCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type harEntry struct {
+	StartedDateTime string      `json:"startedDateTime"`
+	Time            float64     `json:"time"`
+	Request         harRequest  `json:"request"`
+	Response        harResponse `json:"response"`
+}
+
+type harRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+}
+
+// harResponse is filled in from NetworkResponseReceived once the response
+// arrives; it stays zero-valued if the request never got one (e.g. it was
+// still in flight when the test failed).
+type harResponse struct {
+	Status      int    `json:"status"`
+	StatusText  string `json:"statusText"`
+	HTTPVersion string `json:"httpVersion"`
+	MimeType    string `json:"mimeType,omitempty"`
+}
@@ -0,0 +1,74 @@
+// Copyright (C) 2026 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package uitest
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+// TestArtifactCaptureIsolatedPerIncognitoContext exercises the isolation
+// guarantee documented on newArtifactCapture: an artifactCapture attached to
+// one incognito context must not see console events from another incognito
+// context of the same underlying Chromium process, even though RunParallel
+// drives many such contexts through one shared process.
+func TestArtifactCaptureIsolatedPerIncognitoContext(t *testing.T) {
+	launcherLog := zaptest.NewLogger(t).Named("launcher")
+	rodLog := zaptest.NewLogger(t).Named("rod")
+	onPanic := func(v interface{}) { require.Fail(t, "check failed", v) }
+
+	launch, browser := launchBrowser(t, context.Background(), t.TempDir(), launcherLog, rodLog, onPanic)
+	defer func() {
+		launch.Kill()
+		avoidStall(3*time.Second, launch.Cleanup)
+	}()
+	defer func() { _ = browser.Close() }()
+
+	browserA := browser.MustIncognito()
+	defer func() { _ = browserA.Close() }()
+	browserB := browser.MustIncognito()
+	defer func() { _ = browserB.Close() }()
+
+	captureA := newArtifactCapture(browserA)
+	defer captureA.close()
+	captureB := newArtifactCapture(browserB)
+	defer captureB.close()
+
+	pageA := browserA.MustPage("")
+	defer func() { _ = pageA.Close() }()
+	pageB := browserB.MustPage("")
+	defer func() { _ = pageB.Close() }()
+
+	pageA.MustEval(`() => console.log("from-context-a")`)
+	pageB.MustEval(`() => console.log("from-context-b")`)
+
+	require.Eventually(t, func() bool {
+		captureA.mu.Lock()
+		sawA := strings.Join(captureA.console, "\n")
+		captureA.mu.Unlock()
+		return strings.Contains(sawA, "from-context-a")
+	}, 5*time.Second, 50*time.Millisecond, "capture A never saw its own context's console event")
+
+	require.Eventually(t, func() bool {
+		captureB.mu.Lock()
+		sawB := strings.Join(captureB.console, "\n")
+		captureB.mu.Unlock()
+		return strings.Contains(sawB, "from-context-b")
+	}, 5*time.Second, 50*time.Millisecond, "capture B never saw its own context's console event")
+
+	captureA.mu.Lock()
+	sawA := strings.Join(captureA.console, "\n")
+	captureA.mu.Unlock()
+	require.NotContains(t, sawA, "from-context-b", "capture A leaked an event from context B")
+
+	captureB.mu.Lock()
+	sawB := strings.Join(captureB.console, "\n")
+	captureB.mu.Unlock()
+	require.NotContains(t, sawB, "from-context-a", "capture B leaked an event from context A")
+}
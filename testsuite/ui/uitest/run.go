@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"strconv"
+	"sync"
 	"testing"
 	"time"
 
@@ -42,9 +44,10 @@ func (log zapWriter) Write(data []byte) (int, error) {
 	return len(data), nil
 }
 
-// Run starts a new UI test.
-func Run(t *testing.T, test Test) {
-	testplanet.Run(t, testplanet.Config{
+// planetConfig is the testplanet.Config shared by Run and RunParallel; only
+// the NonParallel flag differs between the two entry points.
+func planetConfig(nonParallel bool) testplanet.Config {
+	return testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
 		Reconfigure: testplanet.Reconfigure{
 			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
@@ -54,68 +57,221 @@ func Run(t *testing.T, test Test) {
 				config.Console.NewOnboarding = true
 			},
 		},
-		NonParallel: true,
-	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
-		showBrowser := os.Getenv("STORJ_TEST_SHOW_BROWSER") != ""
-		slowBrowser := os.Getenv("STORJ_TEST_SHOW_BROWSER") == "slow"
-
-		logLauncher := zaptest.NewLogger(t).Named("launcher")
-
-		browserLoaded := browserTimeoutDetector(10 * time.Second)
-		defer browserLoaded()
-
-		launch := launcher.New().
-			Headless(!showBrowser).
-			Leakless(false).
-			Devtools(false).
-			NoSandbox(true).
-			UserDataDir(ctx.Dir("browser")).
-			Logger(zapWriter{Logger: logLauncher}).
-			Set("enable-logging").
-			Set("disable-gpu")
-
-		if browserHost := os.Getenv("STORJ_TEST_BROWER_HOSTPORT"); browserHost != "" {
-			host, port, err := net.SplitHostPort(browserHost)
-			require.NoError(t, err)
-			launch = launch.Set("remote-debugging-address", host).Set(flags.RemoteDebuggingPort, port)
-		}
+		NonParallel: nonParallel,
+	}
+}
 
-		if browserBin := os.Getenv("STORJ_TEST_BROWSER"); browserBin != "" {
-			launch = launch.Bin(browserBin)
-		}
+// Run starts a new UI test with its own dedicated Chromium process.
+func Run(t *testing.T, test Test) {
+	testplanet.Run(t, planetConfig(true), func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		launcherLog := zaptest.NewLogger(t).Named("launcher")
+		rodLog := zaptest.NewLogger(t).Named("rod")
+		onPanic := func(v interface{}) { require.Fail(t, "check failed", v) }
+		launch, browser := launchBrowser(t, ctx, ctx.Dir("browser"), launcherLog, rodLog, onPanic)
 
-		defer func() {
+		// Registered in teardown order (LIFO): capture.save (registered
+		// last, by runTest) must run while the browser is still alive, so
+		// it has to fire before any of these. Plain defers would run
+		// first regardless of registration order and leave nothing to
+		// capture on failure.
+		t.Cleanup(func() {
 			launch.Kill()
 			avoidStall(3*time.Second, launch.Cleanup)
-		}()
+		})
+		t.Cleanup(func() { ctx.Check(browser.Close) })
 
-		url, err := launch.Launch()
+		capture := newArtifactCapture(browser)
+		t.Cleanup(capture.close)
+		runTest(t, ctx, planet, browser, capture, test)
+	})
+}
+
+// browserSlots bounds how many Chromium contexts RunParallel may be
+// actively driving at once; override with STORJ_TEST_UI_MAX_BROWSERS
+// (default 4).
+var browserSlots = make(chan struct{}, maxParallelBrowsers())
+
+func maxParallelBrowsers() int {
+	if v := os.Getenv("STORJ_TEST_UI_MAX_BROWSERS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			return n
+		}
+	}
+	return 4
+}
+
+var (
+	sharedBrowserOnce   sync.Once
+	sharedBrowser       *rod.Browser
+	sharedBrowserLaunch *launcher.Launcher
+	sharedBrowserDir    string
+)
+
+// sharedBrowserLogger backs the shared Chromium process's launcher and rod
+// log output. It deliberately isn't zaptest.NewLogger(t) for any one
+// RunParallel subtest: the shared process outlives whichever subtest wins
+// the getSharedBrowser race below, and once that subtest returns, its T is
+// marked done - a later log line from the still-running shared process
+// (driven by any other parallel test) would call t.Log on a completed test,
+// which panics with "Log in goroutine after <test> has completed" and takes
+// the whole binary down with it.
+var sharedBrowserLogger = newStderrLogger()
+
+func newStderrLogger() *zap.Logger {
+	cfg := zap.NewDevelopmentConfig()
+	cfg.OutputPaths = []string{"stderr"}
+	logger, err := cfg.Build()
+	if err != nil {
+		return zap.NewNop()
+	}
+	return logger
+}
+
+// getSharedBrowser launches, once per package, the single Chromium process
+// that every RunParallel test drives through its own incognito context.
+// Its UserDataDir must outlive any individual test's testcontext.Context,
+// so it gets its own temp directory instead of reusing ctx.Dir. Packages
+// that call RunParallel must call Cleanup from their own TestMain to tear
+// this down; see Cleanup's doc comment.
+func getSharedBrowser(t *testing.T) *rod.Browser {
+	sharedBrowserOnce.Do(func() {
+		dir, err := os.MkdirTemp("", "storj-uitest-browser")
 		require.NoError(t, err)
+		sharedBrowserDir = dir
 
-		logBrowser := zaptest.NewLogger(t).Named("rod")
+		launcherLog := sharedBrowserLogger.Named("launcher")
+		rodLog := sharedBrowserLogger.Named("rod")
+		onPanic := func(v interface{}) {
+			panic(fmt.Sprintf("uitest: shared browser check failed: %v", v))
+		}
+		sharedBrowserLaunch, sharedBrowser = launchBrowser(t, context.Background(), dir, launcherLog, rodLog, onPanic)
+	})
+	return sharedBrowser
+}
 
-		browser := rod.New().
-			Timeout(time.Minute).
-			Sleeper(func() utils.Sleeper { return timeoutSleeper(5*time.Second, 5) }).
-			ControlURL(url).
-			Logger(utils.Log(func(msg ...interface{}) {
-				logBrowser.Info(fmt.Sprintln(msg...))
-			})).
+// Cleanup tears down the Chromium process and temporary user-data directory
+// shared by RunParallel tests in this package. It is a no-op if RunParallel
+// was never called. Packages that use RunParallel must call this from their
+// own TestMain, since the shared browser outlives any individual test and
+// nothing else in the process ever gets a chance to kill it:
+//
+//	func TestMain(m *testing.M) {
+//		code := m.Run()
+//		uitest.Cleanup()
+//		os.Exit(code)
+//	}
+func Cleanup() {
+	if sharedBrowserLaunch == nil {
+		return
+	}
+	sharedBrowserLaunch.Kill()
+	avoidStall(3*time.Second, sharedBrowserLaunch.Cleanup)
+	if sharedBrowserDir != "" {
+		_ = os.RemoveAll(sharedBrowserDir)
+	}
+}
+
+// RunParallel runs a UI test alongside others, sharing one Chromium process
+// per package but giving each test its own incognito browser context, so
+// cookies, storage and navigation state never leak between tests. The
+// number of tests concurrently driving the shared browser is capped by
+// browserSlots.
+func RunParallel(t *testing.T, test Test) {
+	t.Parallel()
+
+	testplanet.Run(t, planetConfig(false), func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		browserSlots <- struct{}{}
+		defer func() { <-browserSlots }()
+
+		var capture *artifactCapture
+		var browser *rod.Browser
+		browser = getSharedBrowser(t).MustIncognito().
 			Context(ctx).
-			WithPanic(func(v interface{}) { require.Fail(t, "check failed", v) })
+			WithPanic(func(v interface{}) {
+				if capture != nil {
+					capture.save(t, ctx, browser)
+				}
+				require.Fail(t, "check failed", v)
+			})
+
+		// See the comment in Run: these must be t.Cleanup, not defer, so
+		// that capture.save (registered last, by runTest) runs first and
+		// still finds a live browser.
+		t.Cleanup(func() { ctx.Check(browser.Close) })
 
-		if slowBrowser {
-			browser = browser.SlowMotion(300 * time.Millisecond).Trace(true)
+		capture = newArtifactCapture(browser)
+		t.Cleanup(capture.close)
+		runTest(t, ctx, planet, browser, capture, test)
+	})
+}
+
+// runTest wires up failure-artifact capture around test and runs it.
+func runTest(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, browser *rod.Browser, capture *artifactCapture, test Test) {
+	t.Cleanup(func() {
+		if t.Failed() {
+			capture.save(t, ctx, browser)
 		}
+	})
 
-		defer ctx.Check(browser.Close)
+	test(t, ctx, planet, browser)
+}
 
-		require.NoError(t, browser.Connect())
+// launchBrowser launches a Chromium process rooted at userDataDir and
+// connects a *rod.Browser to it. launcherLog and rodLog receive the
+// process's own log output, and onPanic is invoked when rod's internal
+// consistency checks fail. Callers whose browser outlives the calling
+// goroutine (the shared-browser path; see sharedBrowserLogger) must pass a
+// logger and panic handler that aren't bound to the calling *testing.T.
+func launchBrowser(t *testing.T, ctx context.Context, userDataDir string, launcherLog, rodLog *zap.Logger, onPanic func(v interface{})) (*launcher.Launcher, *rod.Browser) {
+	showBrowser := os.Getenv("STORJ_TEST_SHOW_BROWSER") != ""
+	slowBrowser := os.Getenv("STORJ_TEST_SHOW_BROWSER") == "slow"
 
-		browserLoaded()
+	browserLoaded := browserTimeoutDetector(10 * time.Second)
+	defer browserLoaded()
 
-		test(t, ctx, planet, browser)
-	})
+	launch := launcher.New().
+		Headless(!showBrowser).
+		Leakless(false).
+		Devtools(false).
+		NoSandbox(true).
+		UserDataDir(userDataDir).
+		Logger(zapWriter{Logger: launcherLog}).
+		Set("enable-logging").
+		Set("disable-gpu")
+
+	if browserHost := os.Getenv("STORJ_TEST_BROWER_HOSTPORT"); browserHost != "" {
+		host, port, err := net.SplitHostPort(browserHost)
+		require.NoError(t, err)
+		launch = launch.Set("remote-debugging-address", host).Set(flags.RemoteDebuggingPort, port)
+	}
+
+	if browserBin := os.Getenv("STORJ_TEST_BROWSER"); browserBin != "" {
+		launch = launch.Bin(browserBin)
+	}
+
+	url, err := launch.Launch()
+	require.NoError(t, err)
+
+	var browser *rod.Browser
+	browser = rod.New().
+		Timeout(time.Minute).
+		Sleeper(func() utils.Sleeper { return timeoutSleeper(5*time.Second, 5) }).
+		ControlURL(url).
+		Logger(utils.Log(func(msg ...interface{}) {
+			rodLog.Info(fmt.Sprintln(msg...))
+		})).
+		Context(ctx).
+		WithPanic(onPanic)
+
+	if slowBrowser {
+		browser = browser.SlowMotion(300 * time.Millisecond).Trace(true)
+	}
+
+	require.NoError(t, browser.Connect())
+
+	browserLoaded()
+
+	return launch, browser
 }
 
 func browserTimeoutDetector(duration time.Duration) context.CancelFunc {